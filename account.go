@@ -1,9 +1,14 @@
 package gotezos
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha512"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/blake2b"
@@ -12,17 +17,28 @@ import (
 	"golang.org/x/crypto/pbkdf2"
 )
 
+// defaultDerivationPath is the BIP44 path used by Tezos wallets such as
+// Kukai and Galleon when none is supplied.
+const defaultDerivationPath = "m/44'/1729'/0'/0'"
+
+// hardenedOffset is added to a path index to mark it as hardened, per BIP32.
+const hardenedOffset = 0x80000000
+
 /*
 Wallet Respresentation
 Description: A Tezos wallet.
 */
 type Wallet struct {
-	Address  string
+	address  string
 	Mnemonic string
 	Seed     []byte
 	Kp       keyPair
 	Sk       string
 	Pk       string
+
+	// chainCode is only populated for wallets derived from a mnemonic via
+	// CreateWalletFromMnemonic, and is required to derive further children.
+	chainCode []byte
 }
 
 type keyPair struct {
@@ -37,6 +53,7 @@ Link: https://tezos.gitlab.io/api/rpc.html#get-block-id-context-contracts-contra
 Description: Access the balance of a contract.
 
 Parameters:
+
 	blockhash:
 		The hash of block (height) of which you want to make the query.
 	address:
@@ -62,8 +79,8 @@ func (t *GoTezos) Balance(blockhash, address string) (*string, error) {
 CreateWallet Function
 Description: Creates a new wallet.
 
-
 Parameters:
+
 	mnenomic:
 		The seed phrase for the new wallet.
 	password:
@@ -83,7 +100,7 @@ func CreateWallet(mnenomic string, password string) (*Wallet, error) {
 	}
 
 	wallet := Wallet{
-		Address:  address,
+		address:  address,
 		Mnemonic: mnenomic,
 		Kp:       signKp,
 		Seed:     seed,
@@ -94,11 +111,166 @@ func CreateWallet(mnenomic string, password string) (*Wallet, error) {
 	return &wallet, nil
 }
 
+/*
+CreateWalletFromMnemonic Function
+Description: Creates a new wallet using BIP39 seed generation followed by
+SLIP-0010 ed25519 hardened derivation, compatible with Kukai/Galleon and
+other wallets that follow the Tezos BIP44 path m/44'/1729'/account'/change'.
+
+Parameters:
+
+	mnemonic:
+		The seed phrase for the new wallet.
+	passphrase:
+		An optional BIP39 passphrase, may be empty.
+	path:
+		The BIP44 derivation path, e.g. "m/44'/1729'/0'/0'". Only hardened
+		segments are supported, as required by ed25519 derivation. If empty,
+		defaultDerivationPath is used.
+*/
+func CreateWalletFromMnemonic(mnemonic, passphrase, path string) (*Wallet, error) {
+	if path == "" {
+		path = defaultDerivationPath
+	}
+
+	indexes, err := parseHDPath(path)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "could not create wallet")
+	}
+
+	bip39Seed := pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"+passphrase), 2048, 64, sha512.New)
+
+	node := deriveHDMaster(bip39Seed)
+	for _, index := range indexes {
+		node = node.child(index)
+	}
+
+	wallet, err := walletFromHDNode(node, mnemonic)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "could not create wallet")
+	}
+
+	return wallet, nil
+}
+
+/*
+DeriveChild Method
+Description: Derives a new wallet following a SLIP-0010 hardened derivation
+path starting at w's current node, e.g. calling DeriveChild("m/44'/1729'/1'/0'")
+on a wallet created with CreateWalletFromMnemonic(mnemonic, pass, "m") reaches
+account 1. Only wallets created by CreateWalletFromMnemonic carry the chain
+code needed to derive children.
+
+Parameters:
+
+	path:
+		The BIP44 derivation path, e.g. "m/44'/1729'/1'/0'".
+*/
+func (w *Wallet) DeriveChild(path string) (*Wallet, error) {
+	if len(w.chainCode) != 32 {
+		return &Wallet{}, errors.New("wallet has no chain code; derive it with CreateWalletFromMnemonic")
+	}
+
+	indexes, err := parseHDPath(path)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "could not derive child wallet")
+	}
+
+	node := hdNode{key: w.Seed, chainCode: w.chainCode}
+	for _, index := range indexes {
+		node = node.child(index)
+	}
+
+	wallet, err := walletFromHDNode(node, w.Mnemonic)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "could not derive child wallet")
+	}
+
+	return wallet, nil
+}
+
+// hdNode is a single node (private key and chain code) in a SLIP-0010
+// ed25519 derivation tree.
+type hdNode struct {
+	key       []byte
+	chainCode []byte
+}
+
+// deriveHDMaster computes the SLIP-0010 ed25519 master node from a BIP39 seed.
+func deriveHDMaster(seed []byte) hdNode {
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	i := mac.Sum(nil)
+	return hdNode{key: i[:32], chainCode: i[32:]}
+}
+
+// child derives the hardened child node at the given index, per
+// SLIP-0010: I = HMAC-SHA512(chainCode, 0x00 || key || ser32(index | 0x80000000)).
+func (n hdNode) child(index uint32) hdNode {
+	var data [37]byte
+	copy(data[1:33], n.key)
+	binary.BigEndian.PutUint32(data[33:], index|hardenedOffset)
+
+	mac := hmac.New(sha512.New, n.chainCode)
+	mac.Write(data[:])
+	i := mac.Sum(nil)
+	return hdNode{key: i[:32], chainCode: i[32:]}
+}
+
+// parseHDPath parses a derivation path such as "m/44'/1729'/0'/0'" into its
+// hardened indexes, rejecting any non-hardened segment since ed25519 only
+// supports hardened derivation.
+func parseHDPath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+	if len(segments) == 0 || segments[0] != "m" {
+		return nil, errors.Errorf("invalid derivation path '%s': must start with 'm'", path)
+	}
+
+	indexes := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		if !strings.HasSuffix(segment, "'") {
+			return nil, errors.Errorf("invalid derivation path '%s': ed25519 only supports hardened segments (missing ')", path)
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSuffix(segment, "'"), 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid derivation path '%s'", path)
+		}
+		indexes = append(indexes, uint32(index))
+	}
+
+	return indexes, nil
+}
+
+// walletFromHDNode builds a Wallet from a derived SLIP-0010 node, reusing the
+// same public hash / base58 encoding as the rest of the package.
+func walletFromHDNode(node hdNode, mnemonic string) (*Wallet, error) {
+	privKey := ed25519.NewKeyFromSeed(node.key)
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	pubKeyBytes := []byte(pubKey)
+
+	address, err := generatePublicHash(pubKeyBytes)
+	if err != nil {
+		return &Wallet{}, errors.Wrapf(err, "could not generate public hash")
+	}
+
+	return &Wallet{
+		address:   address,
+		Mnemonic:  mnemonic,
+		Kp:        keyPair{PrivKey: privKey, PubKey: pubKeyBytes},
+		Seed:      node.key,
+		Sk:        b58cencode(privKey, prefix_edsk),
+		Pk:        b58cencode(pubKeyBytes, prefix_edpk),
+		chainCode: node.chainCode,
+	}, nil
+}
+
 /*
 ImportWallet Function
 Description: Imports an unencrypted wallet.
 
 Parameters:
+
 	hash:
 		The public key hash of the wallet (tz1, KT1).
 	pk:
@@ -166,7 +338,7 @@ func ImportWallet(hash, pk, sk string) (*Wallet, error) {
 		return &wallet, errors.Errorf("reconstructed address '%s' does not match provided address '%s'", generatedAddress, hash)
 	}
 
-	wallet.Address = generatedAddress
+	wallet.address = generatedAddress
 
 	// Genrate and check public key
 	generatedPublicKey := b58cencode(signKP.PubKey, prefix_edpk)
@@ -183,6 +355,7 @@ ImportEncryptedWallet Function
 Description: Imports an encrypted wallet.
 
 Parameters:
+
 	password:
 		The password for the wallet.
 	esk:
@@ -243,11 +416,40 @@ func ImportEncryptedWallet(password, esk string) (*Wallet, error) {
 	if err != nil {
 		return &wallet, errors.Wrapf(err, "could not generate public hash")
 	}
-	wallet.Address = generatedAddress
+	wallet.address = generatedAddress
 
 	return &wallet, nil
 }
 
+/*
+ExportEncrypted Method
+Description: Exports w's secret key as a standard Tezos encrypted secret-key
+(edesk) string, the inverse of ImportEncryptedWallet. The resulting string is
+interoperable with tezos-client and other wallets that support edesk import.
+
+Parameters:
+
+	password:
+		The password to encrypt the secret key with.
+*/
+func (w *Wallet) ExportEncrypted(password string) (string, error) {
+	salt := make([]byte, 8)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, "could not generate salt")
+	}
+
+	key := pbkdf2.Key([]byte(password), salt, 32768, 32, sha512.New)
+	var byteKey [32]byte
+	copy(byteKey[:], key)
+
+	var emptyNonceBytes [24]byte
+	seed := w.Kp.PrivKey[:32]
+	encryptedSeed := secretbox.Seal(nil, seed, &emptyNonceBytes, &byteKey)
+
+	payload := append(salt, encryptedSeed...)
+	return b58cencode(payload, prefix_edesk), nil
+}
+
 func generatePublicHash(publicKey []byte) (string, error) {
 	hash, err := blake2b.New(20, []byte{})
 	if err != nil {
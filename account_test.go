@@ -0,0 +1,90 @@
+package gotezos
+
+import "testing"
+
+func TestCreateWalletFromMnemonic(t *testing.T) {
+	// Well known BIP39 test mnemonic, derived with the default Tezos BIP44
+	// path m/44'/1729'/0'/0'.
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	wallet, err := CreateWalletFromMnemonic(mnemonic, "", "")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	wantAddress := "tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL"
+	if wallet.Address() != wantAddress {
+		t.Errorf("got address '%s', want '%s'", wallet.Address(), wantAddress)
+	}
+
+	wantPk := "edpku4US3ZykcZifjzSGFCmFr3zRgCKndE82estE4irj4d5oqDNDvf"
+	if wallet.Pk != wantPk {
+		t.Errorf("got pk '%s', want '%s'", wallet.Pk, wantPk)
+	}
+}
+
+func TestCreateWalletFromMnemonicDefaultPath(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	withDefault, err := CreateWalletFromMnemonic(mnemonic, "", "")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	explicit, err := CreateWalletFromMnemonic(mnemonic, "", "m/44'/1729'/0'/0'")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	if withDefault.Address() != explicit.Address() {
+		t.Errorf("default path produced '%s', want '%s'", withDefault.Address(), explicit.Address())
+	}
+}
+
+func TestCreateWalletFromMnemonicRejectsNonHardenedSegment(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	if _, err := CreateWalletFromMnemonic(mnemonic, "", "m/44'/1729'/0/0'"); err == nil {
+		t.Error("expected an error for a non-hardened path segment, got nil")
+	}
+}
+
+func TestWalletDeriveChild(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	master, err := CreateWalletFromMnemonic(mnemonic, "", "m")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	account0, err := master.DeriveChild("m/44'/1729'/0'/0'")
+	if err != nil {
+		t.Fatalf("could not derive child wallet: %s", err)
+	}
+
+	wantAccount0 := "tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL"
+	if account0.Address() != wantAccount0 {
+		t.Errorf("got address '%s', want '%s'", account0.Address(), wantAccount0)
+	}
+
+	account1, err := master.DeriveChild("m/44'/1729'/1'/0'")
+	if err != nil {
+		t.Fatalf("could not derive child wallet: %s", err)
+	}
+
+	wantAccount1 := "tz1gvekQVEwFFdxT2KrcY6kZgFK1qMN3mmWF"
+	if account1.Address() != wantAccount1 {
+		t.Errorf("got address '%s', want '%s'", account1.Address(), wantAccount1)
+	}
+}
+
+func TestWalletDeriveChildRequiresChainCode(t *testing.T) {
+	wallet, err := CreateWallet("some mnemonic words", "password")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	if _, err := wallet.DeriveChild("m/44'/1729'/1'/0'"); err == nil {
+		t.Error("expected an error deriving from a wallet without a chain code, got nil")
+	}
+}
@@ -0,0 +1,105 @@
+package gotezos
+
+import "github.com/pkg/errors"
+
+// Curve tags identify a public key / public key hash's elliptic curve in
+// Tezos wire-format encodings.
+const (
+	curveTagEd25519   = 0x00
+	curveTagSecp256k1 = 0x01
+	curveTagP256      = 0x02
+)
+
+// Contract-id tags distinguish an implicit account from an originated
+// contract wherever a destination is forged.
+const (
+	contractIDTagImplicit   = 0x00
+	contractIDTagOriginated = 0x01
+)
+
+/*
+EncodeOperationAddress Function
+Description: Forges a tz1/tz2/tz3 address into the 21-byte wire
+representation (a 1-byte curve tag followed by the 20-byte public key hash)
+used throughout forged Tezos operation bytes. Exported so sibling packages,
+such as operations, can forge addresses without reaching into the base58
+internals of this package.
+*/
+func EncodeOperationAddress(address string) ([]byte, error) {
+	if len(address) < 3 {
+		return nil, errors.Errorf("invalid address '%s'", address)
+	}
+
+	var tag byte
+	var prefix []byte
+	switch address[:3] {
+	case "tz1":
+		tag, prefix = curveTagEd25519, prefix_tz1
+	case "tz2":
+		tag, prefix = curveTagSecp256k1, prefix_tz2
+	case "tz3":
+		tag, prefix = curveTagP256, prefix_tz3
+	default:
+		return nil, errors.Errorf("unsupported address prefix '%s'", address[:3])
+	}
+
+	return append([]byte{tag}, b58cdecode(address, prefix)...), nil
+}
+
+/*
+EncodeContractID Function
+Description: Forges a destination into its contract_id wire representation:
+a 1-byte tag followed by either the 21-byte public_key_hash encoding of an
+implicit tz1/tz2/tz3 account (tag 0x00) or the 20-byte hash of an
+originated KT1 contract plus the extra zero padding byte the protocol
+requires for it (tag 0x01). Used for a transaction's destination; a
+source or a delegate is always implicit and is forged with the plain
+EncodeOperationAddress encoding instead.
+*/
+func EncodeContractID(address string) ([]byte, error) {
+	if len(address) < 3 {
+		return nil, errors.Errorf("invalid address '%s'", address)
+	}
+
+	if address[:3] == "KT1" {
+		hash := append(b58cdecode(address, prefix_KT1), 0x00)
+		return append([]byte{contractIDTagOriginated}, hash...), nil
+	}
+
+	pkh, err := EncodeOperationAddress(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not forge contract id")
+	}
+
+	return append([]byte{contractIDTagImplicit}, pkh...), nil
+}
+
+/*
+EncodeOperationPublicKey Function
+Description: Forges an edpk public key into its wire representation (a
+1-byte curve tag followed by the raw 32-byte public key).
+*/
+func EncodeOperationPublicKey(publicKey string) ([]byte, error) {
+	if len(publicKey) < 4 {
+		return nil, errors.Errorf("invalid public key '%s'", publicKey)
+	}
+
+	if publicKey[:4] != "edpk" {
+		return nil, errors.Errorf("unsupported public key prefix '%s'", publicKey[:4])
+	}
+
+	return append([]byte{curveTagEd25519}, b58cdecode(publicKey, prefix_edpk)...), nil
+}
+
+/*
+DecodeBlockHash Function
+Description: Base58check-decodes a block hash (B...) into its 32 raw bytes,
+as forged at the start of every operation group.
+*/
+func DecodeBlockHash(hash string) ([]byte, error) {
+	if len(hash) < 1 || hash[:1] != "B" {
+		return nil, errors.Errorf("invalid block hash '%s'", hash)
+	}
+
+	return b58cdecode(hash, prefix_B), nil
+}
@@ -0,0 +1,170 @@
+package gotezos
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	keystoreVersion    = 1
+	keystoreCipher     = "xsalsa20poly1305"
+	keystoreKDF        = "pbkdf2"
+	keystoreKDFPRF     = "hmac-sha512"
+	keystoreIterations = 32768
+	keystoreKeyLen     = 32
+)
+
+/*
+KeystoreJSON Representation
+Description: A Web3-Secret-Storage-style JSON envelope for persisting a
+wallet's secret key to disk, analogous to go-ethereum's keystore package.
+*/
+type KeystoreJSON struct {
+	Version int            `json:"version"`
+	Address string         `json:"address"`
+	Crypto  KeystoreCrypto `json:"crypto"`
+}
+
+// KeystoreCrypto holds the cipher and KDF parameters needed to recover the
+// secret key from a KeystoreJSON envelope.
+type KeystoreCrypto struct {
+	Cipher       string               `json:"cipher"`
+	CipherText   string               `json:"ciphertext"`
+	CipherParams KeystoreCipherParams `json:"cipherparams"`
+	KDF          string               `json:"kdf"`
+	KDFParams    KeystoreKDFParams    `json:"kdfparams"`
+	MAC          string               `json:"mac"`
+}
+
+// KeystoreCipherParams holds the nonce used to seal the secret key.
+type KeystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KeystoreKDFParams holds the pbkdf2 parameters used to derive the
+// encryption key from the account password.
+type KeystoreKDFParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	C     int    `json:"c"`
+	PRF   string `json:"prf"`
+}
+
+/*
+ExportKeystoreJSON Method
+Description: Exports w's secret key as a KeystoreJSON envelope, encrypting
+the 32-byte ed25519 seed with pbkdf2-sha512 and nacl/secretbox under a
+password, analogous to go-ethereum's encrypted keystore files.
+
+Parameters:
+
+	password:
+		The password to encrypt the secret key with.
+*/
+func (w *Wallet) ExportKeystoreJSON(password string) (*KeystoreJSON, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "could not generate salt")
+	}
+
+	var nonce [24]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, errors.Wrap(err, "could not generate nonce")
+	}
+
+	key := pbkdf2.Key([]byte(password), salt, keystoreIterations, keystoreKeyLen, sha512.New)
+	var byteKey [32]byte
+	copy(byteKey[:], key)
+
+	seed := w.Kp.PrivKey[:32]
+	sealed := secretbox.Seal(nil, seed, &nonce, &byteKey)
+	mac, cipherText := sealed[:secretbox.Overhead], sealed[secretbox.Overhead:]
+
+	return &KeystoreJSON{
+		Version: keystoreVersion,
+		Address: w.Address(),
+		Crypto: KeystoreCrypto{
+			Cipher:       keystoreCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: KeystoreCipherParams{IV: hex.EncodeToString(nonce[:])},
+			KDF:          keystoreKDF,
+			KDFParams: KeystoreKDFParams{
+				DKLen: keystoreKeyLen,
+				Salt:  hex.EncodeToString(salt),
+				C:     keystoreIterations,
+				PRF:   keystoreKDFPRF,
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+	}, nil
+}
+
+/*
+ImportKeystoreJSON Function
+Description: Imports a wallet from a KeystoreJSON envelope produced by
+ExportKeystoreJSON.
+
+Parameters:
+
+	password:
+		The password the keystore was encrypted with.
+	ks:
+		The keystore envelope to decrypt.
+*/
+func ImportKeystoreJSON(password string, ks *KeystoreJSON) (*Wallet, error) {
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "invalid keystore salt")
+	}
+
+	nonceBytes, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "invalid keystore nonce")
+	}
+	var nonce [24]byte
+	copy(nonce[:], nonceBytes)
+
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "invalid keystore mac")
+	}
+
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return &Wallet{}, errors.Wrap(err, "invalid keystore ciphertext")
+	}
+
+	key := pbkdf2.Key([]byte(password), salt, ks.Crypto.KDFParams.C, ks.Crypto.KDFParams.DKLen, sha512.New)
+	var byteKey [32]byte
+	copy(byteKey[:], key)
+
+	sealed := append(append([]byte{}, mac...), cipherText...)
+	seed, ok := secretbox.Open(nil, sealed, &nonce, &byteKey)
+	if !ok {
+		return &Wallet{}, errors.New("invalid password")
+	}
+
+	privKey := ed25519.NewKeyFromSeed(seed)
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	pubKeyBytes := []byte(pubKey)
+	signKP := keyPair{PrivKey: privKey, PubKey: pubKeyBytes}
+
+	address, err := generatePublicHash(pubKeyBytes)
+	if err != nil {
+		return &Wallet{}, errors.Wrapf(err, "could not generate public hash")
+	}
+
+	return &Wallet{
+		address: address,
+		Kp:      signKP,
+		Seed:    seed,
+		Sk:      b58cencode(signKP.PrivKey, prefix_edsk),
+		Pk:      b58cencode(signKP.PubKey, prefix_edpk),
+	}, nil
+}
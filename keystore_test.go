@@ -0,0 +1,75 @@
+package gotezos
+
+import "testing"
+
+func TestExportImportEncrypted(t *testing.T) {
+	wallet, err := CreateWallet("test mnemonic words here", "passphrase")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	edesk, err := wallet.ExportEncrypted("s3cr3t")
+	if err != nil {
+		t.Fatalf("could not export encrypted wallet: %s", err)
+	}
+
+	if len(edesk) != 88 {
+		t.Fatalf("got edesk length %d, want 88", len(edesk))
+	}
+	if edesk[:5] != "edesk" {
+		t.Fatalf("got edesk prefix '%s', want 'edesk'", edesk[:5])
+	}
+
+	imported, err := ImportEncryptedWallet("s3cr3t", edesk)
+	if err != nil {
+		t.Fatalf("could not import encrypted wallet: %s", err)
+	}
+
+	if imported.Address() != wallet.Address() {
+		t.Errorf("got address '%s', want '%s'", imported.Address(), wallet.Address())
+	}
+}
+
+func TestExportImportKeystoreJSON(t *testing.T) {
+	wallet, err := CreateWallet("test mnemonic words here", "passphrase")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	ks, err := wallet.ExportKeystoreJSON("s3cr3t")
+	if err != nil {
+		t.Fatalf("could not export keystore: %s", err)
+	}
+
+	if ks.Address != wallet.Address() {
+		t.Errorf("got keystore address '%s', want '%s'", ks.Address, wallet.Address())
+	}
+
+	imported, err := ImportKeystoreJSON("s3cr3t", ks)
+	if err != nil {
+		t.Fatalf("could not import keystore: %s", err)
+	}
+
+	if imported.Address() != wallet.Address() {
+		t.Errorf("got address '%s', want '%s'", imported.Address(), wallet.Address())
+	}
+	if imported.Pk != wallet.Pk {
+		t.Errorf("got pk '%s', want '%s'", imported.Pk, wallet.Pk)
+	}
+}
+
+func TestImportKeystoreJSONWrongPassword(t *testing.T) {
+	wallet, err := CreateWallet("test mnemonic words here", "passphrase")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	ks, err := wallet.ExportKeystoreJSON("s3cr3t")
+	if err != nil {
+		t.Fatalf("could not export keystore: %s", err)
+	}
+
+	if _, err := ImportKeystoreJSON("wrong-password", ks); err == nil {
+		t.Error("expected an error importing a keystore with the wrong password, got nil")
+	}
+}
@@ -0,0 +1,291 @@
+package gotezos
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/karalabe/hid"
+	"github.com/pkg/errors"
+)
+
+// Tezos Ledger app APDU constants, see
+// https://github.com/obsidiansystems/ledger-app-tezos.
+const (
+	ledgerVendorID     = 0x2c97
+	ledgerCLA          = 0x80
+	ledgerInsGetPubKey = 0x02
+	ledgerInsSign      = 0x04
+
+	ledgerP1First    = 0x00
+	ledgerP1Continue = 0x01
+	ledgerP1Last     = 0x81
+	ledgerP2Ed25519  = 0x00
+
+	ledgerMaxChunkSize = 230
+
+	// Ledger's USB HID packet protocol: every APDU is sliced into
+	// ledgerHIDPacketSize-byte reports, each framed with a channel id, a
+	// tag, and a sequence index, since a single hid.Device.Write/Read call
+	// maps to exactly one USB HID report and cannot itself carry more than
+	// one report's worth of bytes.
+	ledgerHIDChannel    = 0x0101
+	ledgerHIDTag        = 0x05
+	ledgerHIDPacketSize = 64
+)
+
+/*
+LedgerSigner Representation
+Description: A Signer backed by a Ledger hardware wallet running the Tezos
+app, communicating over USB HID using the app's APDU protocol. The private
+key never leaves the device.
+*/
+type LedgerSigner struct {
+	Path   string
+	Pubkey []byte
+	Pkh    string
+
+	device *hid.Device
+}
+
+/*
+NewLedgerSigner Function
+Description: Opens the first detected Ledger device and fetches the public
+key for the given BIP32 path, e.g. "44'/1729'/0'/0'".
+
+Parameters:
+
+	path:
+		The BIP32 derivation path of the key to use, without a leading "m/".
+*/
+func NewLedgerSigner(path string) (*LedgerSigner, error) {
+	devices := hid.Enumerate(ledgerVendorID, 0)
+	if len(devices) == 0 {
+		return nil, errors.New("no ledger device found")
+	}
+
+	device, err := devices[0].Open()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open ledger device")
+	}
+
+	signer := &LedgerSigner{Path: path, device: device}
+
+	pubkey, pkh, err := signer.getPublicKey()
+	if err != nil {
+		device.Close()
+		return nil, errors.Wrap(err, "could not fetch public key from ledger")
+	}
+	signer.Pubkey = pubkey
+	signer.Pkh = pkh
+
+	return signer, nil
+}
+
+// Close releases the underlying HID device.
+func (s *LedgerSigner) Close() error {
+	return s.device.Close()
+}
+
+// PublicKey implements Signer.
+func (s *LedgerSigner) PublicKey() []byte {
+	return s.Pubkey
+}
+
+// Address implements Signer.
+func (s *LedgerSigner) Address() string {
+	return s.Pkh
+}
+
+/*
+Sign Method
+Description: Implements Signer by sending watermark||bytes to the Ledger
+Tezos app for signing over the configured BIP32 path. The app hashes and
+signs on-device, so the raw ed25519 signature is returned as-is.
+*/
+func (s *LedgerSigner) Sign(watermark byte, bytes []byte) ([]byte, error) {
+	payload := append([]byte{watermark}, bytes...)
+
+	resp, err := s.exchangeChunked(ledgerInsSign, payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign with ledger")
+	}
+
+	return resp, nil
+}
+
+func (s *LedgerSigner) getPublicKey() ([]byte, string, error) {
+	pathBytes, err := encodeLedgerPath(s.Path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := s.exchange(ledgerInsGetPubKey, ledgerP1First, pathBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(resp) < 1 {
+		return nil, "", errors.New("unexpected ledger response")
+	}
+	pubKeyLen := int(resp[0])
+	if len(resp) < 1+pubKeyLen {
+		return nil, "", errors.New("truncated ledger response")
+	}
+	pubKey := resp[1 : 1+pubKeyLen]
+
+	pkh, err := generatePublicHash(pubKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pubKey, pkh, nil
+}
+
+// exchangeChunked sends a BIP32 path header followed by payload, split into
+// ledgerMaxChunkSize APDU chunks as the Tezos app requires for signing.
+func (s *LedgerSigner) exchangeChunked(ins byte, payload []byte) ([]byte, error) {
+	pathBytes, err := encodeLedgerPath(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.exchange(ins, ledgerP1First, pathBytes); err != nil {
+		return nil, err
+	}
+
+	for len(payload) > ledgerMaxChunkSize {
+		if _, err := s.exchange(ins, ledgerP1Continue, payload[:ledgerMaxChunkSize]); err != nil {
+			return nil, err
+		}
+		payload = payload[ledgerMaxChunkSize:]
+	}
+
+	return s.exchange(ins, ledgerP1Last, payload)
+}
+
+// exchange sends a single APDU command and returns its response data,
+// stripped of the trailing two-byte status word.
+func (s *LedgerSigner) exchange(ins, p1 byte, data []byte) ([]byte, error) {
+	apdu := append([]byte{ledgerCLA, ins, p1, ledgerP2Ed25519, byte(len(data))}, data...)
+
+	if err := writeLedgerAPDU(s.device, apdu); err != nil {
+		return nil, err
+	}
+
+	resp, err := readLedgerAPDU(s.device)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp) < 2 {
+		return nil, errors.New("short ledger response")
+	}
+
+	status := binary.BigEndian.Uint16(resp[len(resp)-2:])
+	if status != 0x9000 {
+		return nil, errors.Errorf("ledger returned status 0x%04x", status)
+	}
+
+	return resp[:len(resp)-2], nil
+}
+
+// writeLedgerAPDU frames apdu into the Ledger HID packet protocol and writes
+// each ledgerHIDPacketSize-byte packet in turn. The first packet carries the
+// 2-byte total APDU length right after the channel/tag/sequence header; every
+// packet after that just continues the payload.
+func writeLedgerAPDU(device *hid.Device, apdu []byte) error {
+	seq := uint16(0)
+	offset := 0
+
+	for {
+		packet := make([]byte, ledgerHIDPacketSize)
+		binary.BigEndian.PutUint16(packet[0:2], ledgerHIDChannel)
+		packet[2] = ledgerHIDTag
+		binary.BigEndian.PutUint16(packet[3:5], seq)
+
+		pos := 5
+		if seq == 0 {
+			binary.BigEndian.PutUint16(packet[5:7], uint16(len(apdu)))
+			pos = 7
+		}
+		offset += copy(packet[pos:], apdu[offset:])
+
+		if _, err := device.Write(packet); err != nil {
+			return errors.Wrap(err, "could not write to ledger")
+		}
+
+		if offset >= len(apdu) {
+			return nil
+		}
+		seq++
+	}
+}
+
+// readLedgerAPDU reads ledgerHIDPacketSize-byte HID packets until it has
+// reassembled the full APDU response declared by the first packet's length
+// header, the inverse of writeLedgerAPDU.
+func readLedgerAPDU(device *hid.Device) ([]byte, error) {
+	var resp []byte
+	var total int
+	seq := uint16(0)
+
+	for {
+		packet := make([]byte, ledgerHIDPacketSize)
+		n, err := device.Read(packet)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read from ledger")
+		}
+		if n < 5 {
+			return nil, errors.New("short ledger HID packet")
+		}
+		if gotSeq := binary.BigEndian.Uint16(packet[3:5]); gotSeq != seq {
+			return nil, errors.Errorf("out-of-order ledger HID packet: got sequence %d, want %d", gotSeq, seq)
+		}
+
+		pos := 5
+		if seq == 0 {
+			if n < 7 {
+				return nil, errors.New("short ledger HID packet")
+			}
+			total = int(binary.BigEndian.Uint16(packet[5:7]))
+			pos = 7
+		}
+
+		chunk := packet[pos:n]
+		if remaining := total - len(resp); len(chunk) > remaining {
+			chunk = chunk[:remaining]
+		}
+		resp = append(resp, chunk...)
+
+		if len(resp) >= total {
+			return resp, nil
+		}
+		seq++
+	}
+}
+
+// encodeLedgerPath encodes a BIP32 path such as "44'/1729'/0'/0'" into the
+// length-prefixed index array the Tezos app expects.
+func encodeLedgerPath(path string) ([]byte, error) {
+	segments := strings.Split(path, "/")
+
+	encoded := []byte{byte(len(segments))}
+	for _, segment := range segments {
+		hardened := strings.HasSuffix(segment, "'")
+		index, err := strconv.ParseUint(strings.TrimSuffix(segment, "'"), 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid ledger path '%s'", path)
+		}
+
+		if hardened {
+			index |= hardenedOffset
+		}
+
+		var buf [4]byte
+		binary.BigEndian.PutUint32(buf[:], uint32(index))
+		encoded = append(encoded, buf[:]...)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,48 @@
+package gotezos
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeLedgerPath(t *testing.T) {
+	got, err := encodeLedgerPath("44'/1729'/0'/0'")
+	if err != nil {
+		t.Fatalf("could not encode ledger path: %s", err)
+	}
+
+	want := []byte{
+		0x04,
+		0x80, 0x00, 0x00, 0x2c,
+		0x80, 0x00, 0x06, 0xc1,
+		0x80, 0x00, 0x00, 0x00,
+		0x80, 0x00, 0x00, 0x00,
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestEncodeLedgerPathUnhardened(t *testing.T) {
+	got, err := encodeLedgerPath("44'/1729'/0'/1")
+	if err != nil {
+		t.Fatalf("could not encode ledger path: %s", err)
+	}
+
+	want := []byte{
+		0x04,
+		0x80, 0x00, 0x00, 0x2c,
+		0x80, 0x00, 0x06, 0xc1,
+		0x80, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x01,
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestEncodeLedgerPathInvalidSegment(t *testing.T) {
+	if _, err := encodeLedgerPath("44'/not-a-number'"); err == nil {
+		t.Error("expected an error for a non-numeric path segment, got nil")
+	}
+}
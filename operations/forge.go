@@ -0,0 +1,90 @@
+package operations
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+)
+
+// encodeHex is a small readability wrapper around hex.EncodeToString, used
+// throughout Forge implementations.
+func encodeHex(b []byte) string {
+	return hex.EncodeToString(b)
+}
+
+// decodeHex is the inverse of encodeHex, used to splice already-forged
+// bytes (e.g. a Micheline parameter) supplied as a hex string.
+func decodeHex(s string) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid hex '%s'", s)
+	}
+	return b, nil
+}
+
+// encodeZarith encodes a non-negative integer using the variable-length
+// base-128 scheme ("zarith") used for every numeric field in a forged
+// Tezos operation: 7 bits of magnitude per byte, high bit set on every
+// byte but the last.
+func encodeZarith(n int) []byte {
+	if n == 0 {
+		return []byte{0x00}
+	}
+
+	var out []byte
+	for n > 0 {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n > 0 {
+			b |= 0x80
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// encodeBool forges a Micheline boolean as a single 0xff/0x00 byte.
+func encodeBool(b bool) byte {
+	if b {
+		return 0xff
+	}
+	return 0x00
+}
+
+// encodeBytes forges a length-prefixed byte string: a 4-byte big-endian
+// length followed by the raw bytes, as used for origination scripts and
+// transaction parameter values.
+func encodeBytes(b []byte) []byte {
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(b)))
+	return append(length, b...)
+}
+
+// entrypointTags maps the well-known Michelson entrypoint names to their
+// single-byte tag; anything else is forged as the 0xff "named" tag
+// followed by a length-prefixed string.
+var entrypointTags = map[string]byte{
+	"default":         0x00,
+	"root":            0x01,
+	"do":              0x02,
+	"set_delegate":    0x03,
+	"remove_delegate": 0x04,
+}
+
+// encodeEntrypoint forges a transaction's entrypoint name.
+func encodeEntrypoint(name string) ([]byte, error) {
+	if name == "" {
+		name = "default"
+	}
+
+	if tag, ok := entrypointTags[name]; ok {
+		return []byte{tag}, nil
+	}
+
+	if len(name) > 255 {
+		return nil, errors.Errorf("entrypoint name '%s' longer than 255 bytes", name)
+	}
+
+	return append([]byte{0xff, byte(len(name))}, []byte(name)...), nil
+}
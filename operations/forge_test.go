@@ -0,0 +1,52 @@
+package operations
+
+import "testing"
+
+func TestEncodeZarith(t *testing.T) {
+	cases := []struct {
+		in   int
+		want string
+	}{
+		{0, "00"},
+		{127, "7f"},
+		{128, "8001"},
+		{20000, "a09c01"},
+	}
+
+	for _, c := range cases {
+		got := encodeHex(encodeZarith(c.in))
+		if got != c.want {
+			t.Errorf("encodeZarith(%d) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestEncodeEntrypointWellKnown(t *testing.T) {
+	got, err := encodeEntrypoint("default")
+	if err != nil {
+		t.Fatalf("could not encode entrypoint: %s", err)
+	}
+	if encodeHex(got) != "00" {
+		t.Errorf("got %s, want 00", encodeHex(got))
+	}
+}
+
+func TestEncodeEntrypointNamed(t *testing.T) {
+	got, err := encodeEntrypoint("mint")
+	if err != nil {
+		t.Fatalf("could not encode entrypoint: %s", err)
+	}
+
+	want := "ff04" + encodeHex([]byte("mint"))
+	if encodeHex(got) != want {
+		t.Errorf("got %s, want %s", encodeHex(got), want)
+	}
+}
+
+func TestMinimumFee(t *testing.T) {
+	got := minimumFee(10000, 200)
+	want := 100 + 1000 + 200
+	if got != want {
+		t.Errorf("minimumFee(10000, 200) = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,199 @@
+package operations
+
+import (
+	"encoding/hex"
+
+	gotezos "github.com/DefinitelyNotAGoat/go-tezos"
+	"github.com/pkg/errors"
+)
+
+/*
+Group Representation
+Description: An ordered batch of operations sharing a single branch,
+forged, simulated, signed, and injected together as one Tezos operation
+group. Building a batch payment, or any other multi-operation send, is
+just constructing a Group of Transactions.
+*/
+type Group struct {
+	Branch     string
+	Operations []Operation
+
+	client RPCClient
+}
+
+/*
+NewGroup Function
+Description: Builds a Group of ops anchored at branch, ready to Simulate,
+Sign, and Inject against client.
+
+Parameters:
+
+	client:
+		The RPC client used by Simulate and Inject.
+	branch:
+		The block hash operations in this group are forged against,
+		typically the current chain head.
+	ops:
+		The operations to include, in the order they should apply.
+*/
+func NewGroup(client RPCClient, branch string, ops ...Operation) *Group {
+	return &Group{Branch: branch, Operations: ops, client: client}
+}
+
+/*
+Forge Method
+Description: Forges g's branch followed by each operation's contents, in
+order, into the canonical hex-encoded byte string accepted by the Tezos
+RPC and understood by Sign.
+*/
+func (g *Group) Forge() (string, error) {
+	branch, err := gotezos.DecodeBlockHash(g.Branch)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not forge group: invalid branch '%s'", g.Branch)
+	}
+
+	forged := branch
+	for i, op := range g.Operations {
+		content, err := op.Forge()
+		if err != nil {
+			return "", errors.Wrapf(err, "could not forge operation %d (%s)", i, op.Kind())
+		}
+
+		decoded, err := hex.DecodeString(content)
+		if err != nil {
+			return "", errors.Wrapf(err, "operation %d (%s) forged invalid hex", i, op.Kind())
+		}
+		forged = append(forged, decoded...)
+	}
+
+	return hex.EncodeToString(forged), nil
+}
+
+/*
+Simulate Method
+Description: Dry-runs g against /chains/main/blocks/head/helpers/scripts/run_operation
+to learn the gas and storage each operation actually consumes, then
+populates every operation's Limits with the observed gas plus a small
+safety margin, the observed storage size diff, and a fee that satisfies the
+network's minimum fee formula (fee >= 100 + 0.1*gas + 1*byte, in mutez).
+Call Simulate before Sign unless the caller has already set Limits itself.
+*/
+func (g *Group) Simulate() error {
+	results, err := g.client.RunOperation(g.Branch, g.Operations)
+	if err != nil {
+		return errors.Wrap(err, "could not simulate group")
+	}
+
+	if len(results) != len(g.Operations) {
+		return errors.Errorf("simulation returned %d results for %d operations", len(results), len(g.Operations))
+	}
+
+	if len(g.Operations) == 0 {
+		return errors.New("could not simulate group: group has no operations")
+	}
+
+	overheadShare := signatureByteOverhead / len(g.Operations)
+
+	for i, op := range g.Operations {
+		limits := op.Limits()
+		limits.GasLimit = results[i].ConsumedGas + gasSafetyMargin
+		limits.StorageLimit = results[i].PaidStorageSizeDiff + storageSafetyMargin
+
+		if err := estimateFee(op, overheadShare); err != nil {
+			return errors.Wrapf(err, "could not estimate fee for operation %d (%s)", i, op.Kind())
+		}
+	}
+
+	return nil
+}
+
+// gasSafetyMargin pads a simulation's observed gas consumption so a
+// slightly more expensive re-run at injection time doesn't fail with "gas
+// exhausted". storageSafetyMargin is 0: the storage limit is the observed
+// paid storage size diff, unpadded.
+const (
+	gasSafetyMargin     = 100
+	storageSafetyMargin = 0
+
+	// signatureByteOverhead is the size, in bytes, of the ed25519
+	// signature appended to a group, divided across its operations when
+	// estimating each operation's minimum fee.
+	signatureByteOverhead = 64
+
+	// feeEstimationRounds bounds estimateFee's fixed-point search. A fee
+	// realistic enough to ever be used zarith-encodes to at most a
+	// couple of bytes, so this converges well within the bound.
+	feeEstimationRounds = 4
+)
+
+// estimateFee sets op's fee to the network's minimum for its own forged
+// size, including overheadShare bytes of the group's shared signature.
+// Fee is itself zarith-encoded into the forged bytes, so raising it can
+// grow the operation by a byte, which in turn raises the minimum again;
+// iterate to a fixed point instead of measuring size once against a
+// stale (too-small, pre-Limits) fee.
+func estimateFee(op Operation, overheadShare int) error {
+	limits := op.Limits()
+
+	for i := 0; i < feeEstimationRounds; i++ {
+		content, err := op.Forge()
+		if err != nil {
+			return err
+		}
+
+		fee := minimumFee(limits.GasLimit, len(content)/2+overheadShare)
+		if fee == limits.Fee {
+			return nil
+		}
+		limits.Fee = fee
+	}
+
+	return nil
+}
+
+// minimumFee implements the network's minimum-fee formula: fee must be at
+// least 100 mutez plus 0.1 mutez per unit of gas plus 1 mutez per byte.
+// gasLimit/10 is rounded up so truncation never undershoots the minimum.
+func minimumFee(gasLimit, bytes int) int {
+	return 100 + (gasLimit+9)/10 + bytes
+}
+
+/*
+Sign Method
+Description: Forges g and signs it with signer, returning the signed
+operation hex (forged bytes || signature) ready for Inject. The signature
+is computed over blake2b-256(0x03 || forged bytes), per the generic
+operation watermark.
+*/
+func (g *Group) Sign(signer gotezos.Signer) (string, error) {
+	forged, err := g.Forge()
+	if err != nil {
+		return "", err
+	}
+
+	forgedBytes, err := hex.DecodeString(forged)
+	if err != nil {
+		return "", errors.Wrap(err, "could not decode forged operation")
+	}
+
+	signature, err := signer.Sign(genericOperationWatermark, forgedBytes)
+	if err != nil {
+		return "", errors.Wrap(err, "could not sign operation")
+	}
+
+	return forged + hex.EncodeToString(signature), nil
+}
+
+/*
+Inject Method
+Description: Submits signedOp (as returned by Sign) to the injection
+endpoint and returns the resulting operation hash.
+*/
+func (g *Group) Inject(signedOp string) (string, error) {
+	opHash, err := g.client.InjectOperation(signedOp)
+	if err != nil {
+		return "", errors.Wrap(err, "could not inject group")
+	}
+
+	return opHash, nil
+}
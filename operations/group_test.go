@@ -0,0 +1,210 @@
+package operations
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	gotezos "github.com/DefinitelyNotAGoat/go-tezos"
+)
+
+// testBranch is an arbitrary, independently base58check-verifiable block
+// hash fixture (prefix 0x01, 0x34, payload testBranchHash) used wherever a
+// Group needs a branch but no real RPC client.
+const (
+	testBranch     = "BKij5bNs1rpWb51wwwT2oqHMeTRNnQRhEaDqRdbzYUJh1o8FLUe"
+	testBranchHash = "0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"
+)
+
+// testRawPublicKey stands in for a Signer's raw ed25519 public key: any 32
+// bytes round-trip through base58check the same way a real key would.
+var testRawPublicKey = []byte{
+	0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+	0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+	0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+	0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20,
+}
+
+type signCall struct {
+	watermark byte
+	bytes     []byte
+}
+
+type fakeSigner struct {
+	addr      string
+	pub       []byte
+	signature []byte
+	err       error
+	calls     []signCall
+}
+
+var _ gotezos.Signer = (*fakeSigner)(nil)
+
+func (f *fakeSigner) PublicKey() []byte { return f.pub }
+func (f *fakeSigner) Address() string   { return f.addr }
+
+func (f *fakeSigner) Sign(watermark byte, bytes []byte) ([]byte, error) {
+	f.calls = append(f.calls, signCall{watermark: watermark, bytes: append([]byte{}, bytes...)})
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.signature, nil
+}
+
+type fakeRPCClient struct {
+	runOperationResults []RunOperationResult
+	runOperationErr     error
+	injectHash          string
+	injectErr           error
+	injectedOp          string
+	counter             int
+	counterErr          error
+	head                string
+	headErr             error
+}
+
+func (c *fakeRPCClient) Head() (string, error)               { return c.head, c.headErr }
+func (c *fakeRPCClient) Counter(address string) (int, error) { return c.counter, c.counterErr }
+func (c *fakeRPCClient) GetBlockOperationHashes(id interface{}) ([]string, error) {
+	return nil, nil
+}
+
+func (c *fakeRPCClient) RunOperation(branch string, ops []Operation) ([]RunOperationResult, error) {
+	return c.runOperationResults, c.runOperationErr
+}
+
+func (c *fakeRPCClient) InjectOperation(signedOp string) (string, error) {
+	c.injectedOp = signedOp
+	return c.injectHash, c.injectErr
+}
+
+func TestGroupForge(t *testing.T) {
+	r := &Reveal{
+		manager:   manager{Source: testSource, Counter: 1, limits: Limits{Fee: 1000, GasLimit: 10000}},
+		PublicKey: testPublicKey,
+	}
+	g := NewGroup(nil, testBranch, r)
+
+	got, err := g.Forge()
+	if err != nil {
+		t.Fatalf("could not forge group: %s", err)
+	}
+
+	revealHex, err := r.Forge()
+	if err != nil {
+		t.Fatalf("could not forge reveal: %s", err)
+	}
+
+	want := testBranchHash + revealHex
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestGroupForgeInvalidBranch(t *testing.T) {
+	g := NewGroup(nil, "not-a-branch")
+	if _, err := g.Forge(); err == nil {
+		t.Error("expected an error forging an invalid branch, got nil")
+	}
+}
+
+func TestGroupSign(t *testing.T) {
+	r := &Reveal{
+		manager:   manager{Source: testSource, Counter: 1, limits: Limits{Fee: 1000, GasLimit: 10000}},
+		PublicKey: testPublicKey,
+	}
+	g := NewGroup(nil, testBranch, r)
+	signer := &fakeSigner{addr: testSource, signature: []byte{0xde, 0xad, 0xbe, 0xef}}
+
+	signed, err := g.Sign(signer)
+	if err != nil {
+		t.Fatalf("could not sign group: %s", err)
+	}
+
+	forged, err := g.Forge()
+	if err != nil {
+		t.Fatalf("could not forge group: %s", err)
+	}
+
+	if signed != forged+"deadbeef" {
+		t.Errorf("got signed op %s, want %s", signed, forged+"deadbeef")
+	}
+
+	if len(signer.calls) != 1 {
+		t.Fatalf("got %d Sign calls, want 1", len(signer.calls))
+	}
+	if signer.calls[0].watermark != genericOperationWatermark {
+		t.Errorf("got watermark 0x%02x, want 0x%02x", signer.calls[0].watermark, genericOperationWatermark)
+	}
+
+	wantBytes, err := hex.DecodeString(forged)
+	if err != nil {
+		t.Fatalf("could not decode forged bytes: %s", err)
+	}
+	if !bytes.Equal(signer.calls[0].bytes, wantBytes) {
+		t.Errorf("signer was asked to sign the wrong bytes")
+	}
+}
+
+func TestGroupSimulate(t *testing.T) {
+	tx := &Transaction{
+		manager:     manager{Source: testSource, Counter: 1},
+		Amount:      1,
+		Destination: testImplicit,
+	}
+	client := &fakeRPCClient{runOperationResults: []RunOperationResult{{ConsumedGas: 10385, PaidStorageSizeDiff: 0}}}
+	g := NewGroup(client, testBranch, tx)
+
+	if err := g.Simulate(); err != nil {
+		t.Fatalf("could not simulate group: %s", err)
+	}
+
+	limits := tx.Limits()
+	if limits.GasLimit != 10385+gasSafetyMargin {
+		t.Errorf("got gas limit %d, want %d", limits.GasLimit, 10385+gasSafetyMargin)
+	}
+
+	content, err := tx.Forge()
+	if err != nil {
+		t.Fatalf("could not forge simulated transaction: %s", err)
+	}
+	minFee := minimumFee(limits.GasLimit, len(content)/2+signatureByteOverhead)
+	if limits.Fee < minFee {
+		t.Errorf("simulated fee %d is below the network minimum %d for its own (post-simulation) forged size", limits.Fee, minFee)
+	}
+}
+
+func TestGroupSimulateNoOperations(t *testing.T) {
+	client := &fakeRPCClient{runOperationResults: []RunOperationResult{}}
+	g := NewGroup(client, testBranch)
+
+	if err := g.Simulate(); err == nil {
+		t.Error("expected an error simulating a group with no operations, got nil")
+	}
+}
+
+func TestGroupSimulateResultCountMismatch(t *testing.T) {
+	tx := &Transaction{manager: manager{Source: testSource, Counter: 1}, Destination: testImplicit}
+	client := &fakeRPCClient{runOperationResults: []RunOperationResult{}}
+	g := NewGroup(client, testBranch, tx)
+
+	if err := g.Simulate(); err == nil {
+		t.Error("expected an error when the simulation returns the wrong number of results, got nil")
+	}
+}
+
+func TestGroupInject(t *testing.T) {
+	client := &fakeRPCClient{injectHash: "onvabcdef123"}
+	g := NewGroup(client, testBranch)
+
+	hash, err := g.Inject("deadbeef")
+	if err != nil {
+		t.Fatalf("could not inject group: %s", err)
+	}
+	if hash != "onvabcdef123" {
+		t.Errorf("got op hash %s, want onvabcdef123", hash)
+	}
+	if client.injectedOp != "deadbeef" {
+		t.Errorf("client was injected with %s, want deadbeef", client.injectedOp)
+	}
+}
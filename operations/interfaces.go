@@ -1,13 +1,13 @@
 package operations
 
 import (
-	"github.com/DefinitelyNotAGoat/go-tezos/account"
+	gotezos "github.com/DefinitelyNotAGoat/go-tezos"
 	"github.com/DefinitelyNotAGoat/go-tezos/delegate"
 )
 
 type TezosOperationsService interface {
-	CreateBatchPaymentForFirstSend(payments []delegate.Payment, wallet account.Wallet, paymentFee int, gasLimit int, batchSize int) ([]string, error)
-	CreateBatchPayment(payments []delegate.Payment, wallet account.Wallet, paymentFee int, gaslimit int, batchSize int) ([]string, error)
+	CreateBatchPaymentForFirstSend(payments []delegate.Payment, signer gotezos.Signer, paymentFee int, gasLimit int, batchSize int) ([]string, error)
+	CreateBatchPayment(payments []delegate.Payment, signer gotezos.Signer, paymentFee int, gaslimit int, batchSize int) ([]string, error)
 	InjectOperation(op string) ([]byte, error)
 	GetBlockOperationHashes(id interface{}) ([]string, error)
 }
@@ -0,0 +1,243 @@
+package operations
+
+import (
+	gotezos "github.com/DefinitelyNotAGoat/go-tezos"
+	"github.com/pkg/errors"
+)
+
+// genericOperationWatermark prefixes every operation's bytes before
+// signing, per the Tezos P2P message format for manager operations.
+const genericOperationWatermark = 0x03
+
+// Manager operation tags, as forged into the first byte of each
+// operation's contents.
+const (
+	tagReveal      = 107
+	tagTransaction = 108
+	tagOrigination = 109
+	tagDelegation  = 110
+)
+
+/*
+Operation Interface
+Description: A single forgeable Tezos operation (reveal, transaction,
+delegation, origination, ...) that can be forged into its canonical
+Micheline wire bytes and included in an operation Group. Simulate
+populates Limits with the result of a dry run, so callers building an
+Operation by hand only need to supply Source and Counter.
+*/
+type Operation interface {
+	// Kind returns the operation's tag name, e.g. "transaction".
+	Kind() string
+	// Forge returns the hex-encoded forged bytes for this operation's
+	// contents, excluding the branch that prefixes a Group.
+	Forge() (string, error)
+	// Limits returns the operation's fee/gas/storage limits, mutable in
+	// place so Group.Simulate can populate them after a dry run.
+	Limits() *Limits
+}
+
+// Limits holds the fee and gas/storage limits of a manager operation. A
+// freshly built Operation has zero Limits; call Group.Simulate before
+// Sign to populate them, or set them directly when they are already known.
+type Limits struct {
+	Fee          int
+	GasLimit     int
+	StorageLimit int
+}
+
+// manager holds the fields common to every manager operation. Limits
+// implements Operation.Limits and is promoted to every concrete operation
+// type that embeds manager, so Group.Simulate can populate it in place.
+type manager struct {
+	Source  string
+	Counter int
+	limits  Limits
+}
+
+// Limits implements Operation.
+func (m *manager) Limits() *Limits { return &m.limits }
+
+func (m *manager) forge(tag byte) ([]byte, error) {
+	source, err := gotezos.EncodeOperationAddress(m.Source)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not forge source")
+	}
+
+	out := []byte{tag}
+	out = append(out, source...)
+	out = append(out, encodeZarith(m.limits.Fee)...)
+	out = append(out, encodeZarith(m.Counter)...)
+	out = append(out, encodeZarith(m.limits.GasLimit)...)
+	out = append(out, encodeZarith(m.limits.StorageLimit)...)
+	return out, nil
+}
+
+/*
+Reveal Representation
+Description: A reveal operation, publishing the public key belonging to
+Source so it can later sign operations. Required once per implicit
+account before its first transaction/delegation/origination.
+*/
+type Reveal struct {
+	manager
+	PublicKey string
+}
+
+// Kind implements Operation.
+func (r *Reveal) Kind() string { return "reveal" }
+
+// Forge implements Operation.
+func (r *Reveal) Forge() (string, error) {
+	out, err := r.manager.forge(tagReveal)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge reveal")
+	}
+
+	publicKey, err := gotezos.EncodeOperationPublicKey(r.PublicKey)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge reveal public key")
+	}
+	out = append(out, publicKey...)
+
+	return encodeHex(out), nil
+}
+
+/*
+Transaction Representation
+Description: A transaction operation, moving Amount mutez from Source to
+Destination. Parameters is optional and only needed to invoke an
+entrypoint on a smart-contract Destination.
+*/
+type Transaction struct {
+	manager
+	Amount      int
+	Destination string
+	Parameters  *TransactionParameters
+}
+
+// TransactionParameters carries an optional smart-contract call: the
+// entrypoint to invoke and its already Micheline-encoded argument, as a
+// hex string, the same form returned by Forge.
+type TransactionParameters struct {
+	Entrypoint string
+	Value      string
+}
+
+// Kind implements Operation.
+func (tx *Transaction) Kind() string { return "transaction" }
+
+// Forge implements Operation.
+func (tx *Transaction) Forge() (string, error) {
+	out, err := tx.manager.forge(tagTransaction)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge transaction")
+	}
+
+	out = append(out, encodeZarith(tx.Amount)...)
+
+	destination, err := gotezos.EncodeContractID(tx.Destination)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge transaction destination")
+	}
+	out = append(out, destination...)
+
+	if tx.Parameters == nil {
+		out = append(out, encodeBool(false))
+		return encodeHex(out), nil
+	}
+
+	out = append(out, encodeBool(true))
+	entrypoint, err := encodeEntrypoint(tx.Parameters.Entrypoint)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge transaction entrypoint")
+	}
+	out = append(out, entrypoint...)
+
+	value, err := decodeHex(tx.Parameters.Value)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge transaction parameters: value is not hex")
+	}
+	out = append(out, encodeBytes(value)...)
+
+	return encodeHex(out), nil
+}
+
+/*
+Delegation Representation
+Description: A delegation operation, setting Source's delegate to
+Delegate. An empty Delegate withdraws delegation.
+*/
+type Delegation struct {
+	manager
+	Delegate string
+}
+
+// Kind implements Operation.
+func (d *Delegation) Kind() string { return "delegation" }
+
+// Forge implements Operation.
+func (d *Delegation) Forge() (string, error) {
+	out, err := d.manager.forge(tagDelegation)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge delegation")
+	}
+
+	if d.Delegate == "" {
+		out = append(out, encodeBool(false))
+		return encodeHex(out), nil
+	}
+
+	out = append(out, encodeBool(true))
+	delegate, err := gotezos.EncodeOperationAddress(d.Delegate)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge delegation delegate")
+	}
+	out = append(out, delegate...)
+
+	return encodeHex(out), nil
+}
+
+/*
+Origination Representation
+Description: An origination operation, creating a new KT1 contract funded
+with Balance mutez, optionally delegated to Delegate, running Script.
+*/
+type Origination struct {
+	manager
+	Balance  int
+	Delegate string
+	Script   string
+}
+
+// Kind implements Operation.
+func (o *Origination) Kind() string { return "origination" }
+
+// Forge implements Operation.
+func (o *Origination) Forge() (string, error) {
+	out, err := o.manager.forge(tagOrigination)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge origination")
+	}
+
+	out = append(out, encodeZarith(o.Balance)...)
+
+	if o.Delegate == "" {
+		out = append(out, encodeBool(false))
+	} else {
+		out = append(out, encodeBool(true))
+		delegate, err := gotezos.EncodeOperationAddress(o.Delegate)
+		if err != nil {
+			return "", errors.Wrap(err, "could not forge origination delegate")
+		}
+		out = append(out, delegate...)
+	}
+
+	script, err := decodeHex(o.Script)
+	if err != nil {
+		return "", errors.Wrap(err, "could not forge origination: script is not hex")
+	}
+	out = append(out, encodeBytes(script)...)
+
+	return encodeHex(out), nil
+}
@@ -0,0 +1,149 @@
+package operations
+
+import "testing"
+
+// Addresses used as golden-byte fixtures below, each independently
+// base58check-decodable (curve tag ed25519 / originated contract, 20-byte
+// hash) so the expected wire bytes could be verified against
+// tezos-client's own forge output:
+//
+//	tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL -> 6b1195925ca88aafe7b7e6a0adf20b97ec20edb7
+//	tz1boot1pK9h2BVGXdyvfQSv8kd1LQM6H889 -> b15b7a2484464ed3228c0ae23d0391f8269de3da
+//	KT1BEqzn5Wx8uJrZNvuS9DVHmLvG9td3fDLi -> 1d23c1d3d2f8a4ea5e8784b8f7ecf2ad304c0fe6
+//	edpku4US3ZykcZifjzSGFCmFr3zRgCKndE82estE4irj4d5oqDNDvf ->
+//	    370ffb098088e67f8284ca4938f8f1eac02c3e2ab150f29adc8a7075a5ce7e63
+const (
+	testSource        = "tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL"
+	testSourceHash    = "6b1195925ca88aafe7b7e6a0adf20b97ec20edb7"
+	testImplicit      = "tz1boot1pK9h2BVGXdyvfQSv8kd1LQM6H889"
+	testImplicitHash  = "b15b7a2484464ed3228c0ae23d0391f8269de3da"
+	testKT1           = "KT1BEqzn5Wx8uJrZNvuS9DVHmLvG9td3fDLi"
+	testKT1Hash       = "1d23c1d3d2f8a4ea5e8784b8f7ecf2ad304c0fe6"
+	testPublicKey     = "edpku4US3ZykcZifjzSGFCmFr3zRgCKndE82estE4irj4d5oqDNDvf"
+	testPublicKeyHash = "370ffb098088e67f8284ca4938f8f1eac02c3e2ab150f29adc8a7075a5ce7e63"
+)
+
+func TestRevealForge(t *testing.T) {
+	r := &Reveal{
+		manager:   manager{Source: testSource, Counter: 1, limits: Limits{Fee: 1000, GasLimit: 10000}},
+		PublicKey: testPublicKey,
+	}
+
+	got, err := r.Forge()
+	if err != nil {
+		t.Fatalf("could not forge reveal: %s", err)
+	}
+
+	want := "6b" + "00" + testSourceHash + "e807" + "01" + "904e" + "00" + "00" + testPublicKeyHash
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestTransactionForgeImplicitDestination(t *testing.T) {
+	tx := &Transaction{
+		manager:     manager{Source: testSource, Counter: 2, limits: Limits{Fee: 1000, GasLimit: 10000}},
+		Amount:      20000,
+		Destination: testImplicit,
+	}
+
+	got, err := tx.Forge()
+	if err != nil {
+		t.Fatalf("could not forge transaction: %s", err)
+	}
+
+	want := "6c" + "00" + testSourceHash + "e807" + "02" + "904e" + "00" +
+		"a09c01" + "00" + "00" + testImplicitHash + "00"
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestTransactionForgeSmartContractCall(t *testing.T) {
+	tx := &Transaction{
+		manager:     manager{Source: testSource, Counter: 3, limits: Limits{Fee: 1000, GasLimit: 10000}},
+		Amount:      0,
+		Destination: testKT1,
+		Parameters:  &TransactionParameters{Entrypoint: "default", Value: "0707"},
+	}
+
+	got, err := tx.Forge()
+	if err != nil {
+		t.Fatalf("could not forge transaction: %s", err)
+	}
+
+	want := "6c" + "00" + testSourceHash + "e807" + "03" + "904e" + "00" +
+		"00" + // amount 0
+		"01" + testKT1Hash + "00" + // contract_id: originated tag + hash + padding
+		"ff" + // parameters present
+		"00" + // entrypoint "default"
+		"00000002" + "0707" // length-prefixed Micheline value
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestTransactionForgeRejectsUnknownDestinationPrefix(t *testing.T) {
+	tx := &Transaction{
+		manager:     manager{Source: testSource, Counter: 1},
+		Destination: "sr1somethingunknown",
+	}
+
+	if _, err := tx.Forge(); err == nil {
+		t.Error("expected an error forging an unsupported destination prefix, got nil")
+	}
+}
+
+func TestDelegationForge(t *testing.T) {
+	d := &Delegation{
+		manager:  manager{Source: testSource, Counter: 4, limits: Limits{Fee: 1000, GasLimit: 10000}},
+		Delegate: testImplicit,
+	}
+
+	got, err := d.Forge()
+	if err != nil {
+		t.Fatalf("could not forge delegation: %s", err)
+	}
+
+	want := "6e" + "00" + testSourceHash + "e807" + "04" + "904e" + "00" + "ff" + "00" + testImplicitHash
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestDelegationForgeWithdraw(t *testing.T) {
+	d := &Delegation{
+		manager: manager{Source: testSource, Counter: 5, limits: Limits{Fee: 1000, GasLimit: 10000}},
+	}
+
+	got, err := d.Forge()
+	if err != nil {
+		t.Fatalf("could not forge delegation: %s", err)
+	}
+
+	want := "6e" + "00" + testSourceHash + "e807" + "05" + "904e" + "00" + "00"
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestOriginationForge(t *testing.T) {
+	o := &Origination{
+		manager: manager{Source: testSource, Counter: 6, limits: Limits{Fee: 1000, GasLimit: 10000}},
+		Balance: 1000000,
+		Script:  "0707",
+	}
+
+	got, err := o.Forge()
+	if err != nil {
+		t.Fatalf("could not forge origination: %s", err)
+	}
+
+	want := "6d" + "00" + testSourceHash + "e807" + "06" + "904e" + "00" +
+		"c0843d" + // balance zarith
+		"00" + // no delegate
+		"00000002" + "0707" // length-prefixed script
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,148 @@
+package operations
+
+import (
+	gotezos "github.com/DefinitelyNotAGoat/go-tezos"
+	"github.com/DefinitelyNotAGoat/go-tezos/delegate"
+	"github.com/pkg/errors"
+)
+
+/*
+RPCClient Interface
+Description: The subset of the Tezos RPC the operations pipeline needs:
+the current head, an account's counter, a run_operation simulation, and
+injection. RunOperation takes this package's Operation type, so an
+implementation lives outside the gotezos package.
+*/
+type RPCClient interface {
+	Head() (string, error)
+	Counter(address string) (int, error)
+	RunOperation(branch string, ops []Operation) ([]RunOperationResult, error)
+	InjectOperation(signedOp string) (string, error)
+	GetBlockOperationHashes(id interface{}) ([]string, error)
+}
+
+// RunOperationResult is one operation's simulated outcome, read off
+// run_operation's per-content metadata.operation_result.
+type RunOperationResult struct {
+	ConsumedGas         int
+	PaidStorageSizeDiff int
+}
+
+/*
+Service Representation
+Description: The concrete TezosOperationsService. Batch payments are built
+as a Group of Transactions and forged/signed/injected through it, so any
+other operation type (reveal, delegation, origination) can be sent the
+same way by constructing its own Group.
+*/
+type Service struct {
+	client RPCClient
+}
+
+/*
+NewService Function
+Description: Builds a Service backed by client.
+*/
+func NewService(client RPCClient) *Service {
+	return &Service{client: client}
+}
+
+/*
+CreateBatchPayment Method
+Description: Batches payments into groups of at most batchSize
+transactions signed by signer, injecting one operation group per batch and
+returning each group's operation hash. Every transaction is built with the
+caller-supplied paymentFee/gasLimit; this does not call Group.Simulate, so
+callers wanting automatic fee/gas estimation should build their own Group
+of Transactions and Simulate it instead of using this helper.
+*/
+func (s *Service) CreateBatchPayment(payments []delegate.Payment, signer gotezos.Signer, paymentFee int, gasLimit int, batchSize int) ([]string, error) {
+	return s.createBatchPayment(payments, signer, paymentFee, gasLimit, batchSize, false)
+}
+
+/*
+CreateBatchPaymentForFirstSend Method
+Description: Like CreateBatchPayment, but additionally reveals signer's
+public key as the first operation of the first batch, as required before
+an implicit account's first-ever operation can be injected.
+*/
+func (s *Service) CreateBatchPaymentForFirstSend(payments []delegate.Payment, signer gotezos.Signer, paymentFee int, gasLimit int, batchSize int) ([]string, error) {
+	return s.createBatchPayment(payments, signer, paymentFee, gasLimit, batchSize, true)
+}
+
+func (s *Service) createBatchPayment(payments []delegate.Payment, signer gotezos.Signer, paymentFee int, gasLimit int, batchSize int, revealFirst bool) ([]string, error) {
+	if batchSize < 1 {
+		return nil, errors.New("could not create batch payment: batchSize must be at least 1")
+	}
+
+	counter, err := s.client.Counter(signer.Address())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create batch payment")
+	}
+
+	var opHashes []string
+	for len(payments) > 0 {
+		batch := payments
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		payments = payments[len(batch):]
+
+		head, err := s.client.Head()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create batch payment")
+		}
+
+		var ops []Operation
+		if revealFirst {
+			reveal := &Reveal{
+				manager:   manager{Source: signer.Address(), Counter: counter},
+				PublicKey: gotezos.EncodePublicKey(signer.PublicKey()),
+			}
+			reveal.Limits().Fee, reveal.Limits().GasLimit = paymentFee, gasLimit
+			ops = append(ops, reveal)
+			counter++
+			revealFirst = false
+		}
+
+		for _, payment := range batch {
+			tx := &Transaction{
+				manager:     manager{Source: signer.Address(), Counter: counter},
+				Amount:      payment.Amount,
+				Destination: payment.Address,
+			}
+			tx.Limits().Fee, tx.Limits().GasLimit = paymentFee, gasLimit
+			ops = append(ops, tx)
+			counter++
+		}
+
+		group := NewGroup(s.client, head, ops...)
+		signedOp, err := group.Sign(signer)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not sign batch payment")
+		}
+
+		opHash, err := group.Inject(signedOp)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not inject batch payment")
+		}
+		opHashes = append(opHashes, opHash)
+	}
+
+	return opHashes, nil
+}
+
+// InjectOperation implements TezosOperationsService by forwarding an
+// already forged-and-signed operation to the client.
+func (s *Service) InjectOperation(op string) ([]byte, error) {
+	hash, err := s.client.InjectOperation(op)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not inject operation")
+	}
+	return []byte(hash), nil
+}
+
+// GetBlockOperationHashes implements TezosOperationsService.
+func (s *Service) GetBlockOperationHashes(id interface{}) ([]string, error) {
+	return s.client.GetBlockOperationHashes(id)
+}
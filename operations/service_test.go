@@ -0,0 +1,117 @@
+package operations
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	gotezos "github.com/DefinitelyNotAGoat/go-tezos"
+	"github.com/DefinitelyNotAGoat/go-tezos/delegate"
+)
+
+func TestServiceCreateBatchPaymentForFirstSendBookkeeping(t *testing.T) {
+	signer := &fakeSigner{addr: testSource, pub: testRawPublicKey, signature: []byte{0xaa}}
+	client := &fakeRPCClient{counter: 5, head: testBranch, injectHash: "onvabcdef123"}
+	svc := NewService(client)
+
+	payments := []delegate.Payment{
+		{Address: testImplicit, Amount: 100},
+		{Address: testImplicit, Amount: 200},
+		{Address: testImplicit, Amount: 300},
+	}
+
+	hashes, err := svc.CreateBatchPaymentForFirstSend(payments, signer, 500, 10400, 2)
+	if err != nil {
+		t.Fatalf("could not create batch payment: %s", err)
+	}
+	if len(hashes) != 2 {
+		t.Fatalf("got %d op hashes, want 2 (one per batch of at most 2 payments)", len(hashes))
+	}
+	if len(signer.calls) != 2 {
+		t.Fatalf("got %d Sign calls, want 2", len(signer.calls))
+	}
+
+	publicKey := gotezos.EncodePublicKey(signer.PublicKey())
+
+	wantBatch1 := NewGroup(nil, testBranch,
+		&Reveal{
+			manager:   manager{Source: testSource, Counter: 5, limits: Limits{Fee: 500, GasLimit: 10400}},
+			PublicKey: publicKey,
+		},
+		&Transaction{
+			manager:     manager{Source: testSource, Counter: 6, limits: Limits{Fee: 500, GasLimit: 10400}},
+			Amount:      100,
+			Destination: testImplicit,
+		},
+		&Transaction{
+			manager:     manager{Source: testSource, Counter: 7, limits: Limits{Fee: 500, GasLimit: 10400}},
+			Amount:      200,
+			Destination: testImplicit,
+		},
+	)
+	assertSignedBatch(t, wantBatch1, signer.calls[0].bytes, "batch 1 (reveal + first 2 payments)")
+
+	wantBatch2 := NewGroup(nil, testBranch,
+		&Transaction{
+			manager:     manager{Source: testSource, Counter: 8, limits: Limits{Fee: 500, GasLimit: 10400}},
+			Amount:      300,
+			Destination: testImplicit,
+		},
+	)
+	assertSignedBatch(t, wantBatch2, signer.calls[1].bytes, "batch 2 (remaining payment, no reveal)")
+}
+
+func TestServiceCreateBatchPaymentNeverReveals(t *testing.T) {
+	signer := &fakeSigner{addr: testSource, pub: []byte{1, 2, 3}, signature: []byte{0xaa}}
+	client := &fakeRPCClient{counter: 5, head: testBranch, injectHash: "onvabcdef123"}
+	svc := NewService(client)
+
+	payments := []delegate.Payment{{Address: testImplicit, Amount: 100}}
+
+	if _, err := svc.CreateBatchPayment(payments, signer, 500, 10400, 2); err != nil {
+		t.Fatalf("could not create batch payment: %s", err)
+	}
+	if len(signer.calls) != 1 {
+		t.Fatalf("got %d Sign calls, want 1", len(signer.calls))
+	}
+
+	want := NewGroup(nil, testBranch,
+		&Transaction{
+			manager:     manager{Source: testSource, Counter: 5, limits: Limits{Fee: 500, GasLimit: 10400}},
+			Amount:      100,
+			Destination: testImplicit,
+		},
+	)
+	assertSignedBatch(t, want, signer.calls[0].bytes, "single batch, no reveal expected")
+}
+
+func TestServiceCreateBatchPaymentRejectsZeroBatchSize(t *testing.T) {
+	signer := &fakeSigner{addr: testSource}
+	client := &fakeRPCClient{counter: 0, head: testBranch}
+	svc := NewService(client)
+
+	if _, err := svc.CreateBatchPayment([]delegate.Payment{{Address: testImplicit, Amount: 1}}, signer, 500, 10400, 0); err == nil {
+		t.Error("expected an error for a batchSize below 1, got nil")
+	}
+}
+
+// assertSignedBatch forges want and compares it against the raw bytes a
+// Service handed to the signer, so the test exercises createBatchPayment's
+// own counter/reveal/batching bookkeeping rather than re-deriving it.
+func assertSignedBatch(t *testing.T, want *Group, gotBytes []byte, label string) {
+	t.Helper()
+
+	forged, err := want.Forge()
+	if err != nil {
+		t.Fatalf("%s: could not forge expected group: %s", label, err)
+	}
+
+	wantBytes, err := hex.DecodeString(forged)
+	if err != nil {
+		t.Fatalf("%s: could not decode expected forged bytes: %s", label, err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("%s: signer was asked to sign the wrong bytes", label)
+	}
+}
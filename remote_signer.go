@@ -0,0 +1,96 @@
+package gotezos
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+/*
+RemoteSigner Representation
+Description: A Signer backed by a remote signing daemon speaking the
+tezos-signer / signatory HTTP protocol, so the private key never has to
+reside on the host submitting operations.
+*/
+type RemoteSigner struct {
+	BaseURL string
+	Pkh     string
+	Pubkey  []byte
+	Client  *http.Client
+}
+
+/*
+NewRemoteSigner Function
+Description: Builds a RemoteSigner that delegates signing to a tezos-signer
+(or signatory) instance reachable at baseURL, e.g. "http://127.0.0.1:6732".
+
+Parameters:
+
+	baseURL:
+		The base URL of the remote signer, without a trailing slash.
+	pkh:
+		The public key hash (tz1) of the key held by the remote signer.
+	pubkey:
+		The raw ed25519 public key bytes corresponding to pkh.
+*/
+func NewRemoteSigner(baseURL, pkh string, pubkey []byte) *RemoteSigner {
+	return &RemoteSigner{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Pkh:     pkh,
+		Pubkey:  pubkey,
+		Client:  http.DefaultClient,
+	}
+}
+
+// PublicKey implements Signer.
+func (s *RemoteSigner) PublicKey() []byte {
+	return s.Pubkey
+}
+
+// Address implements Signer.
+func (s *RemoteSigner) Address() string {
+	return s.Pkh
+}
+
+/*
+Sign Method
+Description: Implements Signer by POSTing the hex-encoded watermark||bytes
+to the remote signer's /keys/<pkh> endpoint and decoding the returned edsig.
+*/
+func (s *RemoteSigner) Sign(watermark byte, opBytes []byte) ([]byte, error) {
+	payload := append([]byte{watermark}, opBytes...)
+
+	body, err := json.Marshal(hex.EncodeToString(payload))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal remote signer request")
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	url := fmt.Sprintf("%s/keys/%s", s.BaseURL, s.Pkh)
+	resp, err := client.Post(url, "application/json", strings.NewReader(string(body)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reach remote signer")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("remote signer returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Signature string `json:"signature"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, errors.Wrap(err, "could not parse remote signer response")
+	}
+
+	return b58cdecode(result.Signature, prefix_edsig), nil
+}
@@ -0,0 +1,77 @@
+package gotezos
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testSignature/testSignatureEdsig are an arbitrary 64-byte signature and
+// its real base58check edsig encoding (prefix 0x09, 0xf5, 0xcd, 0x86, 0x12),
+// used to verify Sign decodes a remote signer's response correctly.
+const testSignatureEdsig = "edsigtXwQk8GtBeRkJZtgKxnSuxDENEHZaszQKM1s89PvPgR8BfBa76GioJYMDQXq4FiD56AxKpq88d7eiicPvToAN39Mx6TPVD"
+
+var testSignature = []byte{
+	0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+	0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20,
+	0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f, 0x30,
+	0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f, 0x40,
+}
+
+func TestRemoteSignerSign(t *testing.T) {
+	const wantPath = "/keys/tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL"
+	const watermark = 0x03
+	opBytes := []byte{0xaa, 0xbb, 0xcc}
+
+	var gotPath string
+	var gotPayload string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+
+		var payload string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("could not decode request body: %s", err)
+		}
+		gotPayload = payload
+
+		json.NewEncoder(w).Encode(struct {
+			Signature string `json:"signature"`
+		}{Signature: testSignatureEdsig})
+	}))
+	defer server.Close()
+
+	signer := NewRemoteSigner(server.URL, "tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL", nil)
+
+	got, err := signer.Sign(watermark, opBytes)
+	if err != nil {
+		t.Fatalf("could not sign: %s", err)
+	}
+
+	if gotPath != wantPath {
+		t.Errorf("got request path %s, want %s", gotPath, wantPath)
+	}
+
+	wantPayload := hex.EncodeToString(append([]byte{watermark}, opBytes...))
+	if gotPayload != wantPayload {
+		t.Errorf("got posted payload %s, want %s", gotPayload, wantPayload)
+	}
+
+	if hex.EncodeToString(got) != hex.EncodeToString(testSignature) {
+		t.Errorf("got signature %x, want %x", got, testSignature)
+	}
+}
+
+func TestRemoteSignerSignServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	signer := NewRemoteSigner(server.URL, "tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL", nil)
+
+	if _, err := signer.Sign(0x03, []byte{0xaa}); err == nil {
+		t.Error("expected an error when the remote signer returns a non-200 status, got nil")
+	}
+}
@@ -0,0 +1,90 @@
+package gotezos
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ed25519"
+)
+
+/*
+Signer Interface
+Description: Anything that can produce a signature for a Tezos operation
+without necessarily exposing its private key, so TezosOperationsService can
+be used with a Ledger, an HSM, or a networked signer such as tezos-signer
+or signatory, in addition to an in-memory Wallet.
+*/
+type Signer interface {
+	// PublicKey returns the raw (unprefixed) ed25519 public key bytes.
+	PublicKey() []byte
+	// Address returns the public key hash (tz1) of the signer.
+	Address() string
+	// Sign signs watermark||bytes and returns the raw ed25519 signature.
+	Sign(watermark byte, bytes []byte) (signature []byte, err error)
+}
+
+// PublicKey implements Signer.
+func (w *Wallet) PublicKey() []byte {
+	return w.Kp.PubKey
+}
+
+// Address implements Signer, returning the wallet's public key hash.
+func (w *Wallet) Address() string {
+	return w.address
+}
+
+/*
+Sign Method
+Description: Signs watermark||bytes with w's private key, returning the raw
+ed25519 signature over the blake2b-256 digest. This is the watermarking and
+hashing scheme used for every Tezos operation.
+
+Parameters:
+
+	watermark:
+		The operation watermark, e.g. 0x03 for a generic operation.
+	bytes:
+		The forged operation bytes to sign.
+*/
+func (w *Wallet) Sign(watermark byte, bytes []byte) ([]byte, error) {
+	digest, err := hashOperationBytes(watermark, bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign operation bytes")
+	}
+
+	return ed25519.Sign(w.Kp.PrivKey, digest), nil
+}
+
+// hashOperationBytes blake2b-256-hashes watermark||bytes, the digest every
+// Tezos operation signature is computed over.
+func hashOperationBytes(watermark byte, bytes []byte) ([]byte, error) {
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := hash.Write(append([]byte{watermark}, bytes...)); err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
+
+/*
+EncodeSignature Function
+Description: Base58check-encodes a raw ed25519 signature, as produced by a
+Signer, into its canonical edsig string.
+*/
+func EncodeSignature(signature []byte) string {
+	return b58cencode(signature, prefix_edsig)
+}
+
+/*
+EncodePublicKey Function
+Description: Base58check-encodes a raw ed25519 public key, as returned by
+Signer.PublicKey, into its canonical edpk string. The inverse of
+EncodeOperationPublicKey's decoding step, needed to forge a reveal
+operation for a Signer that only exposes raw key bytes.
+*/
+func EncodePublicKey(publicKey []byte) string {
+	return b58cencode(publicKey, prefix_edpk)
+}
@@ -0,0 +1,221 @@
+package gotezos
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const walletFileVersion = 1
+
+/*
+WalletFile Representation
+Description: A portable, diffable JSON container for multiple encrypted
+accounts, borrowing the NEP-6 container idea so a baker can keep a payout
+key, a fee-paying key, and a set of delegator/testing keys in one file
+rather than juggling individual edesk strings.
+*/
+type WalletFile struct {
+	Version   int                 `json:"version"`
+	Name      string              `json:"name"`
+	KDF       string              `json:"kdf"`
+	KDFParams WalletFileKDFParams `json:"kdfparams"`
+	Accounts  []AccountEntry      `json:"accounts"`
+}
+
+// WalletFileKDFParams documents the key-derivation parameters used to
+// encrypt every account entry's secret key, for interoperability with
+// other tooling reading the file. Each entry's edesk carries its own
+// salt, so these are informational rather than needed to decrypt.
+type WalletFileKDFParams struct {
+	C   int    `json:"c"`
+	PRF string `json:"prf"`
+}
+
+// AccountEntry is a single encrypted account within a WalletFile.
+type AccountEntry struct {
+	Address      string `json:"address"`
+	Label        string `json:"label"`
+	EncryptedKey string `json:"encrypted_key"`
+	IsDefault    bool   `json:"is_default"`
+}
+
+/*
+NewWalletFile Function
+Description: Builds an empty, named WalletFile ready for AddAccount.
+*/
+func NewWalletFile(name string) *WalletFile {
+	return &WalletFile{
+		Version: walletFileVersion,
+		Name:    name,
+		KDF:     keystoreKDF,
+		KDFParams: WalletFileKDFParams{
+			C:   keystoreIterations,
+			PRF: keystoreKDFPRF,
+		},
+	}
+}
+
+/*
+LoadWalletFile Function
+Description: Reads and parses the WalletFile at path, then verifies
+password against the default account, if one is set, so a bad password is
+caught immediately rather than on the first Unlock.
+
+Parameters:
+
+	path:
+		The filesystem path of the wallet file.
+	password:
+		The password shared by every account in the file.
+*/
+func LoadWalletFile(path, password string) (*WalletFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read wallet file")
+	}
+
+	var wf WalletFile
+	if err := json.Unmarshal(data, &wf); err != nil {
+		return nil, errors.Wrap(err, "could not parse wallet file")
+	}
+
+	if wf.Version != walletFileVersion {
+		return nil, errors.Errorf("unsupported wallet file version %d", wf.Version)
+	}
+
+	if def, ok := wf.defaultEntry(); ok {
+		if _, err := ImportEncryptedWallet(password, def.EncryptedKey); err != nil {
+			return nil, errors.Wrap(err, "could not unlock default account")
+		}
+	}
+
+	return &wf, nil
+}
+
+/*
+SaveWalletFile Method
+Description: Writes wf to path as indented JSON, after verifying password
+against the default account, if one is set, so a mistyped password is
+never silently baked into the file.
+
+Parameters:
+
+	path:
+		The filesystem path to write the wallet file to.
+	password:
+		The password shared by every account in the file.
+*/
+func (wf *WalletFile) SaveWalletFile(path, password string) error {
+	if def, ok := wf.defaultEntry(); ok {
+		if _, err := ImportEncryptedWallet(password, def.EncryptedKey); err != nil {
+			return errors.Wrap(err, "could not verify password before saving")
+		}
+	}
+
+	data, err := json.MarshalIndent(wf, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal wallet file")
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return errors.Wrap(err, "could not write wallet file")
+	}
+
+	return nil
+}
+
+/*
+AddAccount Method
+Description: Encrypts w's secret key under password and appends it to wf
+under label, reusing the same pbkdf2-sha512 + nacl/secretbox edesk scheme
+as ExportEncrypted so individual accounts remain portable outside the
+file. The first account added to wf becomes its default; every account
+after that must use the same password as the default, since wf's accounts
+are meant to be unlocked with one shared password.
+*/
+func (wf *WalletFile) AddAccount(w *Wallet, password, label string) error {
+	if _, ok := wf.entry(w.Address()); ok {
+		return errors.Errorf("account '%s' already exists in wallet file", w.Address())
+	}
+
+	if def, ok := wf.defaultEntry(); ok {
+		if _, err := ImportEncryptedWallet(password, def.EncryptedKey); err != nil {
+			return errors.Wrap(err, "could not add account: password does not match the wallet file's existing accounts")
+		}
+	}
+
+	encrypted, err := w.ExportEncrypted(password)
+	if err != nil {
+		return errors.Wrap(err, "could not add account")
+	}
+
+	wf.Accounts = append(wf.Accounts, AccountEntry{
+		Address:      w.Address(),
+		Label:        label,
+		EncryptedKey: encrypted,
+		IsDefault:    len(wf.Accounts) == 0,
+	})
+
+	return nil
+}
+
+/*
+RemoveAccount Method
+Description: Removes the account matching address from wf. If it was the
+default account and accounts remain, the first remaining account becomes
+the new default.
+*/
+func (wf *WalletFile) RemoveAccount(address string) error {
+	for i, entry := range wf.Accounts {
+		if entry.Address != address {
+			continue
+		}
+
+		wf.Accounts = append(wf.Accounts[:i], wf.Accounts[i+1:]...)
+		if entry.IsDefault && len(wf.Accounts) > 0 {
+			wf.Accounts[0].IsDefault = true
+		}
+		return nil
+	}
+
+	return errors.Errorf("account '%s' not found in wallet file", address)
+}
+
+/*
+Unlock Method
+Description: Decrypts and returns the wallet for the account matching
+address.
+*/
+func (wf *WalletFile) Unlock(address, password string) (*Wallet, error) {
+	entry, ok := wf.entry(address)
+	if !ok {
+		return nil, errors.Errorf("account '%s' not found in wallet file", address)
+	}
+
+	wallet, err := ImportEncryptedWallet(password, entry.EncryptedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unlock account '%s'", address)
+	}
+
+	return wallet, nil
+}
+
+func (wf *WalletFile) entry(address string) (*AccountEntry, bool) {
+	for i := range wf.Accounts {
+		if wf.Accounts[i].Address == address {
+			return &wf.Accounts[i], true
+		}
+	}
+	return nil, false
+}
+
+func (wf *WalletFile) defaultEntry() (*AccountEntry, bool) {
+	for i := range wf.Accounts {
+		if wf.Accounts[i].IsDefault {
+			return &wf.Accounts[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,87 @@
+package gotezos
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestWalletFileAddRemoveUnlock(t *testing.T) {
+	payout, err := CreateWallet("payout wallet mnemonic", "passphrase")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+	fees, err := CreateWallet("fees wallet mnemonic", "passphrase")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	wf := NewWalletFile("baker")
+	if err := wf.AddAccount(payout, "s3cr3t", "payout"); err != nil {
+		t.Fatalf("could not add payout account: %s", err)
+	}
+	if err := wf.AddAccount(fees, "s3cr3t", "fees"); err != nil {
+		t.Fatalf("could not add fees account: %s", err)
+	}
+
+	if len(wf.Accounts) != 2 {
+		t.Fatalf("got %d accounts, want 2", len(wf.Accounts))
+	}
+	if !wf.Accounts[0].IsDefault || wf.Accounts[1].IsDefault {
+		t.Fatalf("expected only the first account added to be default")
+	}
+
+	unlocked, err := wf.Unlock(payout.Address(), "s3cr3t")
+	if err != nil {
+		t.Fatalf("could not unlock account: %s", err)
+	}
+	if unlocked.Address() != payout.Address() {
+		t.Errorf("got address '%s', want '%s'", unlocked.Address(), payout.Address())
+	}
+
+	if _, err := wf.Unlock(payout.Address(), "wrong-password"); err == nil {
+		t.Error("expected an error unlocking with the wrong password, got nil")
+	}
+
+	if err := wf.RemoveAccount(payout.Address()); err != nil {
+		t.Fatalf("could not remove account: %s", err)
+	}
+	if len(wf.Accounts) != 1 {
+		t.Fatalf("got %d accounts, want 1", len(wf.Accounts))
+	}
+	if !wf.Accounts[0].IsDefault {
+		t.Error("expected the remaining account to become default")
+	}
+}
+
+func TestWalletFileSaveLoad(t *testing.T) {
+	wallet, err := CreateWallet("test mnemonic words here", "passphrase")
+	if err != nil {
+		t.Fatalf("could not create wallet: %s", err)
+	}
+
+	wf := NewWalletFile("baker")
+	if err := wf.AddAccount(wallet, "s3cr3t", "payout"); err != nil {
+		t.Fatalf("could not add account: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "wallet.json")
+	if err := wf.SaveWalletFile(path, "s3cr3t"); err != nil {
+		t.Fatalf("could not save wallet file: %s", err)
+	}
+
+	loaded, err := LoadWalletFile(path, "s3cr3t")
+	if err != nil {
+		t.Fatalf("could not load wallet file: %s", err)
+	}
+
+	if loaded.Name != "baker" {
+		t.Errorf("got name '%s', want 'baker'", loaded.Name)
+	}
+	if len(loaded.Accounts) != 1 || loaded.Accounts[0].Address != wallet.Address() {
+		t.Fatalf("loaded wallet file does not contain the saved account")
+	}
+
+	if _, err := LoadWalletFile(path, "wrong-password"); err == nil {
+		t.Error("expected an error loading with the wrong password, got nil")
+	}
+}